@@ -1,6 +1,7 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"errors"
@@ -10,6 +11,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"time"
 
@@ -35,6 +37,8 @@ var (
 	fileSelectionDlg  bool
 	colorSelectionDlg bool
 	notification      bool
+	progressDlg       bool
+	formsDlg          bool
 
 	// General options
 	title       string
@@ -73,6 +77,17 @@ var (
 	defaultColor string
 	showPalette  bool
 
+	// Progress options
+	pulsate       bool
+	autoClose     bool
+	autoKill      bool
+	noCancel      bool
+	timeRemaining bool
+
+	// Forms options
+	formOpts   []zenity.Option
+	formLabels []string
+
 	// Windows specific options
 	cygpath bool
 	wslpath bool
@@ -137,6 +152,12 @@ func main() {
 
 	case notification:
 		errResult(zenity.Notify(text, opts...))
+
+	case progressDlg:
+		progressResult(zenity.Progress(opts...))
+
+	case formsDlg:
+		formsResult(zenity.Forms(opts...))
 	}
 
 	flag.Usage()
@@ -154,6 +175,8 @@ func setupFlags() {
 	flag.BoolVar(&fileSelectionDlg, "file-selection", false, "Display file selection dialog")
 	flag.BoolVar(&colorSelectionDlg, "color-selection", false, "Display color selection dialog")
 	flag.BoolVar(&notification, "notification", false, "Display notification")
+	flag.BoolVar(&progressDlg, "progress", false, "Display progress indication dialog")
+	flag.BoolVar(&formsDlg, "forms", false, "Display forms dialog")
 
 	// General options
 	flag.StringVar(&title, "title", "", "Set the dialog `title`")
@@ -194,6 +217,19 @@ func setupFlags() {
 	flag.StringVar(&defaultColor, "color", "", "Set the `color`")
 	flag.BoolVar(&showPalette, "show-palette", false, "Show the palette")
 
+	// Progress options
+	flag.BoolVar(&pulsate, "pulsate", false, "Pulsate progress bar")
+	flag.BoolVar(&autoClose, "auto-close", false, "Dismiss the dialog when 100% has been reached")
+	flag.BoolVar(&autoKill, "auto-kill", false, "Kill parent process if Cancel button is pressed")
+	flag.BoolVar(&noCancel, "no-cancel", false, "Hide Cancel button")
+	flag.BoolVar(&timeRemaining, "time-remaining", false, "Estimate when the progress will reach 100%")
+
+	// Forms options
+	flag.Var(funcValue(addFormEntry), "add-entry", "Add a new entry `label` in forms dialog")
+	flag.Var(funcValue(addFormPassword), "add-password", "Add a new password `label` in forms dialog")
+	flag.Var(funcValue(addFormCalendar), "add-calendar", "Add a new calendar `label` in forms dialog")
+	flag.Var(funcValue(addFormCombo), "add-combo", "Add a new combo box `label` in forms dialog (append |item1|item2... for its values)")
+
 	// Windows specific options
 	if runtime.GOOS == "windows" {
 		flag.BoolVar(&cygpath, "cygpath", false, "Use cygpath for path translation (Windows only)")
@@ -245,6 +281,12 @@ func validateFlags() {
 	if notification {
 		n++
 	}
+	if progressDlg {
+		n++
+	}
+	if formsDlg {
+		n++
+	}
 	if n != 1 {
 		flag.Usage()
 	}
@@ -388,6 +430,31 @@ func loadFlags() []zenity.Option {
 		opts = append(opts, zenity.ShowPalette())
 	}
 
+	// Progress options
+
+	if progressDlg {
+		opts = append(opts, zenity.Text(text))
+	}
+	if pulsate {
+		opts = append(opts, zenity.Pulsate())
+	}
+	if autoClose {
+		opts = append(opts, zenity.AutoClose())
+	}
+	if autoKill {
+		opts = append(opts, zenity.AutoKill())
+	}
+	if noCancel {
+		opts = append(opts, zenity.NoCancel())
+	}
+	if timeRemaining {
+		opts = append(opts, zenity.TimeRemaining())
+	}
+
+	// Forms options
+
+	opts = append(opts, formOpts...)
+
 	return opts
 }
 
@@ -466,6 +533,56 @@ func strOKResult(s string, ok bool, err error) {
 	os.Exit(0)
 }
 
+func progressResult(dlg zenity.ProgressDialog, err error) {
+	if err != nil {
+		errResult(err)
+	}
+	defer dlg.Close()
+
+	scanner := bufio.NewScanner(os.Stdin)
+scan:
+	for scanner.Scan() {
+		select {
+		case <-dlg.Done():
+			break scan
+		default:
+		}
+
+		line := scanner.Text()
+		if strings.HasPrefix(line, "#") {
+			dlg.Text(strings.TrimPrefix(line, "#"))
+			continue
+		}
+		if n, err := strconv.Atoi(strings.TrimSuffix(line, "%")); err == nil {
+			dlg.Value(n)
+		}
+	}
+
+	select {
+	case <-dlg.Done():
+		os.Exit(1)
+	default:
+		dlg.Complete()
+		os.Exit(0)
+	}
+}
+
+func formsResult(m map[string]string, ok bool, err error) {
+	if err != nil {
+		errResult(err)
+	}
+	if !ok {
+		os.Exit(1)
+	}
+	values := make([]string, len(formLabels))
+	for i, label := range formLabels {
+		values[i] = m[label]
+	}
+	os.Stdout.WriteString(strings.Join(values, zenutil.Separator))
+	os.Stdout.WriteString(zenutil.LineBreak)
+	os.Exit(0)
+}
+
 func ingestPath(path string) string {
 	if runtime.GOOS == "windows" && path != "" {
 		var args []string
@@ -546,3 +663,33 @@ func addFileFilter(s string) error {
 
 	return nil
 }
+
+func addFormEntry(s string) error {
+	formLabels = append(formLabels, s)
+	formOpts = append(formOpts, zenity.AddEntry(s))
+	return nil
+}
+
+func addFormPassword(s string) error {
+	formLabels = append(formLabels, s)
+	formOpts = append(formOpts, zenity.AddPassword(s))
+	return nil
+}
+
+func addFormCalendar(s string) error {
+	formLabels = append(formLabels, s)
+	formOpts = append(formOpts, zenity.AddCalendar(s))
+	return nil
+}
+
+func addFormCombo(s string) error {
+	label := s
+	var items []string
+	if split := strings.SplitN(s, "|", 2); len(split) > 1 {
+		label = split[0]
+		items = strings.Split(split[1], "|")
+	}
+	formLabels = append(formLabels, label)
+	formOpts = append(formOpts, zenity.AddCombo(label, items))
+	return nil
+}
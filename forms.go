@@ -0,0 +1,65 @@
+package zenity
+
+type formFieldKind int
+
+const (
+	formFieldEntry formFieldKind = iota
+	formFieldPassword
+	formFieldCalendar
+	formFieldCombo
+)
+
+type formField struct {
+	kind  formFieldKind
+	label string
+	items []string
+}
+
+// Forms displays a dialog with multiple labelled fields, added with AddEntry,
+// AddPassword, AddCalendar and AddCombo, and returns the entered values keyed
+// by label.
+//
+// Supported options: Title, Width, Height, OKLabel, CancelLabel, AddEntry,
+// AddPassword, AddCalendar, AddCombo, Context.
+//
+// Zenity: --forms
+func Forms(opts ...Option) (map[string]string, bool, error) {
+	return forms(applyOptions(opts))
+}
+
+// AddEntry adds a text entry field labelled label to a Forms dialog.
+//
+// Zenity: --add-entry
+func AddEntry(label string) Option {
+	return func(o *options) {
+		o.formFields = append(o.formFields, formField{kind: formFieldEntry, label: label})
+	}
+}
+
+// AddPassword adds a password entry field labelled label to a Forms dialog.
+//
+// Zenity: --add-password
+func AddPassword(label string) Option {
+	return func(o *options) {
+		o.formFields = append(o.formFields, formField{kind: formFieldPassword, label: label})
+	}
+}
+
+// AddCalendar adds a calendar field labelled label to a Forms dialog.
+//
+// Zenity: --add-calendar
+func AddCalendar(label string) Option {
+	return func(o *options) {
+		o.formFields = append(o.formFields, formField{kind: formFieldCalendar, label: label})
+	}
+}
+
+// AddCombo adds a combo box field labelled label, populated with items, to a
+// Forms dialog.
+//
+// Zenity: --add-combo, --combo-values
+func AddCombo(label string, items []string) Option {
+	return func(o *options) {
+		o.formFields = append(o.formFields, formField{kind: formFieldCombo, label: label, items: items})
+	}
+}
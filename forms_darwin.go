@@ -0,0 +1,51 @@
+package zenity
+
+import (
+	"encoding/json"
+	"os/exec"
+
+	"github.com/ncruces/zenity/internal/zenutil"
+)
+
+func forms(opts options) (map[string]string, bool, error) {
+	data := zenutil.Forms{}
+	if opts.title != "" {
+		data.Options.Title = &opts.title
+	}
+	if opts.okLabel != "" {
+		data.Options.OK = &opts.okLabel
+	}
+	if opts.cancelLabel != "" {
+		data.Options.Cancel = &opts.cancelLabel
+	}
+	for _, f := range opts.formFields {
+		var kind string
+		switch f.kind {
+		case formFieldEntry:
+			kind = "entry"
+		case formFieldPassword:
+			kind = "password"
+		case formFieldCalendar:
+			kind = "calendar"
+		case formFieldCombo:
+			kind = "combo"
+		}
+		data.Options.Fields = append(data.Options.Fields,
+			zenutil.FormField{Kind: kind, Label: f.label, Items: f.items})
+	}
+
+	out, err := zenutil.Run(opts.ctx, "forms", data)
+	if err != nil {
+		if _, ok := err.(*exec.ExitError); ok {
+			// The window was closed or Cancel was pressed.
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var values map[string]string
+	if err := json.Unmarshal(out, &values); err != nil {
+		return nil, false, err
+	}
+	return values, true, nil
+}
@@ -0,0 +1,44 @@
+// +build !windows,!darwin
+
+package zenity
+
+import (
+	"strings"
+
+	"github.com/ncruces/zenity/internal/zenutil"
+)
+
+func forms(opts options) (map[string]string, bool, error) {
+	args := []string{"--forms"}
+
+	args = appendTitle(args, opts)
+	args = appendButtons(args, opts)
+
+	for _, f := range opts.formFields {
+		switch f.kind {
+		case formFieldEntry:
+			args = append(args, "--add-entry", f.label)
+		case formFieldPassword:
+			args = append(args, "--add-password", f.label)
+		case formFieldCalendar:
+			args = append(args, "--add-calendar", f.label)
+		case formFieldCombo:
+			args = append(args, "--add-combo", f.label, "--combo-values", strings.Join(f.items, "|"))
+		}
+	}
+
+	out, err := zenutil.Run(opts.ctx, args)
+	str, ok, err := strResult(opts, out, err)
+	if !ok {
+		return nil, ok, err
+	}
+
+	values := strings.Split(str, zenutil.Separator)
+	result := make(map[string]string, len(opts.formFields))
+	for i, f := range opts.formFields {
+		if i < len(values) {
+			result[f.label] = values[i]
+		}
+	}
+	return result, true, nil
+}
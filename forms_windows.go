@@ -0,0 +1,247 @@
+package zenity
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"syscall"
+	"unsafe"
+)
+
+var (
+	user32                  = syscall.NewLazyDLL("user32.dll")
+	dialogBoxIndirectParamW = user32.NewProc("DialogBoxIndirectParamW")
+	getDlgItemTextW         = user32.NewProc("GetDlgItemTextW")
+	getDlgItem              = user32.NewProc("GetDlgItem")
+	endDialog               = user32.NewProc("EndDialog")
+
+	initCommonControlsEx = comctl32.NewProc("InitCommonControlsEx")
+)
+
+const (
+	wmInitDialog = 0x0110
+	wmCommand    = 0x0111
+
+	idOK     = 1
+	idCancel = 2
+
+	dsSetFont    = 0x40
+	dsModalFrame = 0x0080
+	wsPopup      = 0x80000000
+	wsCaption    = 0x00C00000
+	wsSysMenu    = 0x00080000
+	wsChild      = 0x40000000
+	wsVisible    = 0x10000000
+	wsTabStop    = 0x00010000
+	esPassword   = 0x0020
+
+	// Built-in DLGITEMTEMPLATE control class ordinals.
+	classButton   = 0x0080
+	classEdit     = 0x0081
+	classStatic   = 0x0082
+	classComboBox = 0x0085
+
+	csDropDownList = 0x0003
+	cbsHasStrings  = 0x0200
+
+	cbAddString = 0x0143
+	cbSetCurSel = 0x014E
+
+	dtmFirst         = 0x1000
+	dtmGetSystemTime = dtmFirst + 1
+
+	iccDateClasses = 0x00000100
+)
+
+type initCommonControlsExStruct struct {
+	size uint32
+	icc  uint32
+}
+
+type systemTime struct {
+	year, month, dayOfWeek, day, hour, minute, second, milliseconds uint16
+}
+
+// formsDialog carries per-instance state across the dialog procedure, which
+// the Win32 API invokes without a user-supplied closure.
+type formsDialog struct {
+	fields []formField
+	values map[string]string
+	ok     bool
+}
+
+func forms(opts options) (map[string]string, bool, error) {
+	icc := initCommonControlsExStruct{icc: iccDateClasses}
+	icc.size = uint32(unsafe.Sizeof(icc))
+	initCommonControlsEx.Call(uintptr(unsafe.Pointer(&icc)))
+
+	tmpl := buildFormsTemplate(opts)
+
+	dlg := &formsDialog{fields: opts.formFields, values: map[string]string{}}
+	cb := syscall.NewCallback(dlg.proc)
+
+	dialogBoxIndirectParamW.Call(
+		0,
+		uintptr(unsafe.Pointer(&tmpl[0])),
+		0,
+		cb,
+		0)
+
+	return dlg.values, dlg.ok, nil
+}
+
+func (d *formsDialog) proc(hwnd uintptr, msg uint32, wparam, lparam uintptr) uintptr {
+	switch msg {
+	case wmInitDialog:
+		for i, f := range d.fields {
+			if f.kind != formFieldCombo {
+				continue
+			}
+			child := childHwnd(hwnd, 100+i)
+			for _, item := range f.items {
+				sendMessageStr(child, cbAddString, utf16ptr(item))
+			}
+			if len(f.items) > 0 {
+				sendMessage(child, cbSetCurSel, 0, 0)
+			}
+		}
+		return 1
+	case wmCommand:
+		switch loword(wparam) {
+		case idOK:
+			for i, f := range d.fields {
+				d.values[f.label] = getFieldValue(hwnd, 100+i, f.kind)
+			}
+			d.ok = true
+			endDialog.Call(hwnd, 1)
+		case idCancel:
+			endDialog.Call(hwnd, 0)
+		}
+	}
+	return 0
+}
+
+func getFieldValue(hwnd uintptr, id int, kind formFieldKind) string {
+	if kind == formFieldCalendar {
+		child := childHwnd(hwnd, id)
+		var st systemTime
+		sendMessage(child, dtmGetSystemTime, 0, uintptr(unsafe.Pointer(&st)))
+		return fmt.Sprintf("%04d-%02d-%02d", st.year, st.month, st.day)
+	}
+	return getDlgItemText(hwnd, id)
+}
+
+func childHwnd(hwnd uintptr, id int) uintptr {
+	h, _, _ := getDlgItem.Call(hwnd, uintptr(id))
+	return h
+}
+
+func getDlgItemText(hwnd uintptr, id int) string {
+	buf := make([]uint16, 1024)
+	n, _, _ := getDlgItemTextW.Call(hwnd, uintptr(id), uintptr(unsafe.Pointer(&buf[0])), uintptr(len(buf)))
+	return syscall.UTF16ToString(buf[:n])
+}
+
+func sendMessageStr(hwnd uintptr, msg uint32, s *uint16) uintptr {
+	return sendMessage(hwnd, msg, 0, uintptr(unsafe.Pointer(s)))
+}
+
+func loword(v uintptr) uint16 {
+	return uint16(v & 0xffff)
+}
+
+// buildFormsTemplate lays out an in-memory DLGTEMPLATE with a static label
+// and an appropriate control per field (edit, password edit, combo box, or
+// date picker), followed by OK and Cancel buttons, for use with
+// DialogBoxIndirectParam.
+func buildFormsTemplate(opts options) []byte {
+	const (
+		rowHeight = 14
+		dlgWidth  = 200
+	)
+
+	var b bytes.Buffer
+	write := func(v interface{}) { binary.Write(&b, binary.LittleEndian, v) }
+
+	title := opts.title
+	height := uint16(len(opts.formFields)*rowHeight + 28)
+
+	// DLGTEMPLATE header. WS_POPUP|WS_CAPTION|WS_SYSMENU|WS_VISIBLE give the
+	// dialog a titled, closable, visible window; DS_MODALFRAME|DS_SETFONT are
+	// the usual bits for a DialogBoxIndirectParam template with a font.
+	write(uint32(wsPopup | wsCaption | wsSysMenu | dsModalFrame | dsSetFont | wsVisible)) // style
+	write(uint32(0))                         // dwExtendedStyle
+	write(uint16(len(opts.formFields)*2 + 2)) // cdit
+	write(int16(0))                          // x
+	write(int16(0))                          // y
+	write(int16(dlgWidth))                   // cx
+	write(int16(height))                     // cy
+	write(uint16(0))                         // menu
+	write(uint16(0))                         // windowClass
+	writeUTF16Z(&b, title)                   // title
+	write(uint16(8))                         // pointsize
+	writeUTF16Z(&b, "MS Shell Dlg")          // typeface
+
+	y := int16(6)
+	for i, f := range opts.formFields {
+		writeDlgItem(&b, classStatic, "", f.label, -1, 6, y, dlgWidth-12, 8, wsChild|wsVisible)
+
+		switch f.kind {
+		case formFieldCombo:
+			style := uint32(wsChild | wsVisible | wsTabStop | csDropDownList | cbsHasStrings)
+			// cy must include room for the drop-down list, not just the edit row.
+			writeDlgItem(&b, classComboBox, "", "", 100+i, 6, y+9, dlgWidth-12, 80, style)
+		case formFieldCalendar:
+			style := uint32(wsChild | wsVisible | wsTabStop)
+			writeDlgItem(&b, 0, "SysDateTimePick32", "", 100+i, 6, y+9, dlgWidth-12, 12, style)
+		default:
+			style := uint32(wsChild | wsVisible | wsTabStop)
+			if f.kind == formFieldPassword {
+				style |= esPassword
+			}
+			writeDlgItem(&b, classEdit, "", "", 100+i, 6, y+9, dlgWidth-12, 12, style)
+		}
+		y += rowHeight
+	}
+
+	writeDlgItem(&b, classButton, "", "OK", idOK, dlgWidth-86, y+4, 40, 14, wsChild|wsVisible|wsTabStop)
+	writeDlgItem(&b, classButton, "", "Cancel", idCancel, dlgWidth-42, y+4, 40, 14, wsChild|wsVisible|wsTabStop)
+
+	return b.Bytes()
+}
+
+// writeDlgItem appends a DLGITEMTEMPLATE. Pass either a built-in control
+// class ordinal (class, e.g. classButton/classEdit/classComboBox) or the
+// name of a registered window class (className, e.g. "SysDateTimePick32"),
+// never both.
+func writeDlgItem(b *bytes.Buffer, class uint16, className, text string, id int, x, y, cx, cy int16, style uint32) {
+	for b.Len()%4 != 0 {
+		b.WriteByte(0)
+	}
+	write := func(v interface{}) { binary.Write(b, binary.LittleEndian, v) }
+
+	write(style)
+	write(uint32(0))
+	write(x)
+	write(y)
+	write(cx)
+	write(cy)
+	write(uint16(id))
+
+	if className != "" {
+		writeUTF16Z(b, className)
+	} else {
+		write(uint16(0xffff))
+		write(class)
+	}
+	writeUTF16Z(b, text)
+	write(uint16(0))
+}
+
+func writeUTF16Z(b *bytes.Buffer, s string) {
+	u, err := syscall.UTF16FromString(s)
+	if err != nil {
+		u = []uint16{0}
+	}
+	binary.Write(b, binary.LittleEndian, u)
+}
@@ -2,6 +2,7 @@ package zenutil
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"os/exec"
@@ -47,6 +48,61 @@ func Run(ctx context.Context, script string, data interface{}) ([]byte, error) {
 	return cmd.Output()
 }
 
+// RunPipe is internal. Like Run, it renders script with data, but instead of
+// waiting for the result it starts the script in the background and returns
+// a pipe connected to its stdin, plus a channel that closes when the script
+// exits. It is meant for dialogs that need to be updated after they are
+// shown, such as the progress dialog. Once done is closed, wait returns the
+// script's exit error, if any (e.g. an *exec.ExitError when the dialog was
+// cancelled).
+func RunPipe(ctx context.Context, script string, data interface{}) (stdin io.WriteCloser, done <-chan struct{}, wait func() error, err error) {
+	var buf strings.Builder
+
+	err = scripts.ExecuteTemplate(&buf, script, data)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tmp, err := ioutil.TempFile("", "*.js")
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	name := tmp.Name()
+	_, err = tmp.WriteString(buf.String())
+	tmp.Close()
+	if err != nil {
+		os.Remove(name)
+		return nil, nil, nil, err
+	}
+
+	var cmd *exec.Cmd
+	if ctx != nil {
+		cmd = exec.CommandContext(ctx, "osascript", "-l", "JavaScript", name)
+	} else {
+		cmd = exec.Command("osascript", "-l", "JavaScript", name)
+	}
+
+	stdin, err = cmd.StdinPipe()
+	if err != nil {
+		os.Remove(name)
+		return nil, nil, nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		os.Remove(name)
+		return nil, nil, nil, err
+	}
+
+	doneCh := make(chan struct{})
+	var waitErr error
+	go func() {
+		waitErr = cmd.Wait()
+		os.Remove(name)
+		close(doneCh)
+	}()
+
+	return stdin, doneCh, func() error { return waitErr }, nil
+}
+
 // File is internal.
 type File struct {
 	Operation string
@@ -116,6 +172,41 @@ type NotifyOptions struct {
 	Subtitle string  `json:"subtitle,omitempty"`
 }
 
+// Forms is internal.
+type Forms struct {
+	Options FormsOptions
+}
+
+// FormsOptions is internal.
+type FormsOptions struct {
+	Title  *string     `json:"withTitle,omitempty"`
+	OK     *string     `json:"okButtonName,omitempty"`
+	Cancel *string     `json:"cancelButtonName,omitempty"`
+	Fields []FormField `json:"fields,omitempty"`
+}
+
+// FormField is internal.
+type FormField struct {
+	Kind  string   `json:"kind"`
+	Label string   `json:"label"`
+	Items []string `json:"items,omitempty"`
+}
+
+// Progress is internal.
+type Progress struct {
+	Options ProgressOptions
+}
+
+// ProgressOptions is internal.
+type ProgressOptions struct {
+	Title         *string `json:"withTitle,omitempty"`
+	Text          *string `json:"withText,omitempty"`
+	Pulsate       bool    `json:"pulsate,omitempty"`
+	AutoClose     bool    `json:"autoClose,omitempty"`
+	NoCancel      bool    `json:"noCancel,omitempty"`
+	TimeRemaining bool    `json:"timeRemaining,omitempty"`
+}
+
 type Buttons struct {
 	Buttons []string
 	Default int
@@ -0,0 +1,75 @@
+package zenity
+
+// ProgressDialog is the handle returned by Progress. Its methods are safe to
+// call from any goroutine.
+type ProgressDialog interface {
+	// Text replaces the dialog text.
+	Text(text string) error
+	// Value sets the progress value, out of MaxValue (100 unless set).
+	// Stops any previously started Pulsate.
+	Value(value int) error
+	// MaxValue sets the progress maximum value (100 by default).
+	MaxValue(value int) error
+	// Complete sets the progress to its maximum value.
+	Complete() error
+	// Close closes the dialog.
+	Close() error
+	// Done returns a channel that is closed once the dialog is closed, be it
+	// by Close, by Complete (with AutoClose), or by the user clicking Cancel.
+	Done() <-chan struct{}
+}
+
+// Progress displays a progress indication dialog.
+//
+// Supported options: Title, Text, Width, Height, OKLabel, CancelLabel,
+// Pulsate, AutoClose, AutoKill, NoCancel, TimeRemaining, Context.
+//
+// Zenity: --progress
+func Progress(opts ...Option) (ProgressDialog, error) {
+	return progress(applyOptions(opts))
+}
+
+// Text sets the initial dialog text. Progress has no text argument of its
+// own (unlike Error, Info, etc.), so this is how its initial text is set.
+//
+// Zenity: --text
+func Text(text string) Option {
+	return func(o *options) { o.text = text }
+}
+
+// Pulsate starts a pulsating (indeterminate) progress bar. A subsequent call
+// to Value switches the dialog back to a determinate progress bar.
+//
+// Zenity: --pulsate
+func Pulsate() Option {
+	return func(o *options) { o.pulsate = true }
+}
+
+// AutoClose closes the progress dialog as soon as it reaches 100%.
+//
+// Zenity: --auto-close
+func AutoClose() Option {
+	return func(o *options) { o.autoClose = true }
+}
+
+// AutoKill kills the parent process if the Cancel button is pressed.
+//
+// Zenity: --auto-kill
+func AutoKill() Option {
+	return func(o *options) { o.autoKill = true }
+}
+
+// NoCancel hides the Cancel button.
+//
+// Zenity: --no-cancel
+func NoCancel() Option {
+	return func(o *options) { o.noCancel = true }
+}
+
+// TimeRemaining shows an estimate of the time remaining until the dialog
+// reaches 100%.
+//
+// Zenity: --time-remaining
+func TimeRemaining() Option {
+	return func(o *options) { o.timeRemaining = true }
+}
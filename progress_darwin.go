@@ -0,0 +1,102 @@
+package zenity
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"strconv"
+	"sync"
+
+	"github.com/ncruces/zenity/internal/zenutil"
+)
+
+func progress(opts options) (ProgressDialog, error) {
+	data := zenutil.Progress{Options: zenutil.ProgressOptions{
+		Pulsate:       opts.pulsate,
+		AutoClose:     opts.autoClose,
+		NoCancel:      opts.noCancel,
+		TimeRemaining: opts.timeRemaining,
+	}}
+	if opts.title != "" {
+		data.Options.Title = &opts.title
+	}
+	if opts.text != "" {
+		data.Options.Text = &opts.text
+	}
+
+	stdin, done, wait, err := zenutil.RunPipe(opts.ctx, "progress", data)
+	if err != nil {
+		return nil, err
+	}
+	p := &darwinProgress{stdin: stdin, done: done, max: 100}
+	if opts.autoKill {
+		go p.killOnCancel(wait)
+	}
+	return p, nil
+}
+
+// darwinProgress drives a JXA window that polls its stdin for "value:" and
+// "text:" lines, and exits (closing done) when the user clicks Cancel or
+// (with AutoClose) when the value reaches its maximum.
+type darwinProgress struct {
+	stdin io.WriteCloser
+	done  <-chan struct{}
+
+	mu  sync.Mutex
+	max int
+}
+
+// killOnCancel kills the current process once the dialog exits, but only if
+// it was cancelled (the script exits with a non-zero status), implementing
+// AutoKill's "kill parent process if Cancel is pressed" semantics.
+func (p *darwinProgress) killOnCancel(wait func() error) {
+	<-p.done
+	if _, ok := wait().(*exec.ExitError); ok {
+		if proc, err := os.FindProcess(os.Getpid()); err == nil {
+			proc.Kill()
+		}
+	}
+}
+
+func (p *darwinProgress) writeLine(s string) error {
+	select {
+	case <-p.done:
+		return io.ErrClosedPipe
+	default:
+	}
+	_, err := io.WriteString(p.stdin, s+"\n")
+	return err
+}
+
+func (p *darwinProgress) Text(text string) error {
+	return p.writeLine("text:" + text)
+}
+
+func (p *darwinProgress) Value(value int) error {
+	p.mu.Lock()
+	max := p.max
+	p.mu.Unlock()
+	if max <= 0 {
+		max = 100
+	}
+	return p.writeLine("value:" + strconv.Itoa(value*100/max))
+}
+
+func (p *darwinProgress) MaxValue(value int) error {
+	p.mu.Lock()
+	p.max = value
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *darwinProgress) Complete() error {
+	return p.writeLine("value:100")
+}
+
+func (p *darwinProgress) Close() error {
+	return p.stdin.Close()
+}
+
+func (p *darwinProgress) Done() <-chan struct{} {
+	return p.done
+}
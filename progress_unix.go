@@ -0,0 +1,123 @@
+// +build !windows,!darwin
+
+package zenity
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"strconv"
+	"sync"
+)
+
+func progress(opts options) (ProgressDialog, error) {
+	args := []string{"--progress"}
+
+	args = appendTitle(args, opts)
+	if opts.text != "" {
+		args = append(args, "--text", opts.text)
+	}
+	if opts.width > 0 {
+		args = append(args, "--width", strconv.FormatUint(uint64(opts.width), 10))
+	}
+	if opts.height > 0 {
+		args = append(args, "--height", strconv.FormatUint(uint64(opts.height), 10))
+	}
+	if opts.okLabel != "" {
+		args = append(args, "--ok-label", opts.okLabel)
+	}
+	if opts.cancelLabel != "" {
+		args = append(args, "--cancel-label", opts.cancelLabel)
+	}
+	if opts.pulsate {
+		args = append(args, "--pulsate")
+	}
+	if opts.autoClose {
+		args = append(args, "--auto-close")
+	}
+	if opts.autoKill {
+		args = append(args, "--auto-kill")
+	}
+	if opts.noCancel {
+		args = append(args, "--no-cancel")
+	}
+	if opts.timeRemaining {
+		args = append(args, "--time-remaining")
+	}
+
+	var cmd *exec.Cmd
+	if opts.ctx != nil {
+		cmd = exec.CommandContext(opts.ctx, "zenity", args...)
+	} else {
+		cmd = exec.Command("zenity", args...)
+	}
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+
+	dlg := &unixProgress{cmd: cmd, stdin: stdin, max: 100, done: make(chan struct{})}
+	go dlg.wait()
+	return dlg, nil
+}
+
+type unixProgress struct {
+	cmd   *exec.Cmd
+	stdin io.WriteCloser
+	done  chan struct{}
+
+	mu  sync.Mutex
+	max int
+}
+
+func (p *unixProgress) wait() {
+	p.cmd.Wait()
+	close(p.done)
+}
+
+func (p *unixProgress) writeLine(s string) error {
+	select {
+	case <-p.done:
+		return context.Canceled
+	default:
+	}
+	_, err := io.WriteString(p.stdin, s+"\n")
+	return err
+}
+
+func (p *unixProgress) Text(text string) error {
+	return p.writeLine("#" + text)
+}
+
+func (p *unixProgress) Value(value int) error {
+	p.mu.Lock()
+	max := p.max
+	p.mu.Unlock()
+	if max <= 0 {
+		max = 100
+	}
+	return p.writeLine(strconv.Itoa(value * 100 / max))
+}
+
+func (p *unixProgress) MaxValue(value int) error {
+	p.mu.Lock()
+	p.max = value
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *unixProgress) Complete() error {
+	return p.writeLine("100")
+}
+
+func (p *unixProgress) Close() error {
+	return p.stdin.Close()
+}
+
+func (p *unixProgress) Done() <-chan struct{} {
+	return p.done
+}
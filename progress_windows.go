@@ -0,0 +1,270 @@
+package zenity
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+var (
+	comctl32           = syscall.NewLazyDLL("comctl32.dll")
+	taskDialogIndirect = comctl32.NewProc("TaskDialogIndirect")
+)
+
+const (
+	tdfAllowDialogCancellation = 0x0008
+	tdfShowProgressBar         = 0x0200
+	tdfShowMarqueeProgressBar  = 0x0400
+	tdfCallbackTimer           = 0x0800
+
+	tdcbfOKButton     = 0x0001
+	tdcbfCancelButton = 0x0008
+
+	tdnCreated       = 0
+	tdnButtonClicked = 2
+	tdnTimer         = 4
+	tdnDestroyed     = 5
+
+	wmUser = 0x0400
+
+	// Message IDs from CommCtrl.h (TDM_*).
+	tdmUpdateElementText     = wmUser + 114
+	tdmSetMarqueeProgressBar = wmUser + 103
+	tdmSetProgressBarRange   = wmUser + 105
+	tdmSetProgressBarPos     = wmUser + 106
+	tdmSetProgressBarMarquee = wmUser + 107
+
+	tdeMainInstruction = 3
+)
+
+type taskDialogConfig struct {
+	size            uint32
+	hwnd            uintptr
+	instance        uintptr
+	flags           uint32
+	commonButtons   uint32
+	windowTitle     *uint16
+	mainIcon        uintptr
+	mainInstruction *uint16
+	content         *uint16
+	buttonCount     uint32
+	buttons         uintptr
+	defaultButton   int32
+	radioCount      uint32
+	radioButtons    uintptr
+	defaultRadio    int32
+	verification    *uint16
+	expandedInfo    *uint16
+	expandedLabel   *uint16
+	collapsedLabel  *uint16
+	footerIcon      uintptr
+	footer          *uint16
+	callback        uintptr
+	callbackData    uintptr
+	width           uint32
+}
+
+func progress(opts options) (ProgressDialog, error) {
+	p := &windowsProgress{
+		max:           100,
+		text:          opts.text,
+		autoClose:     opts.autoClose,
+		autoKill:      opts.autoKill,
+		timeRemaining: opts.timeRemaining,
+		done:          make(chan struct{}),
+		hwnd:          make(chan uintptr, 1),
+	}
+
+	go p.run(opts)
+
+	select {
+	case hwnd := <-p.hwnd:
+		p.hwnd <- hwnd
+		return p, nil
+	case <-p.done:
+		p.mu.Lock()
+		err := p.err
+		p.mu.Unlock()
+		return nil, err
+	}
+}
+
+type windowsProgress struct {
+	hwnd chan uintptr
+	done chan struct{}
+
+	autoClose     bool
+	autoKill      bool
+	timeRemaining bool
+
+	mu        sync.Mutex
+	max       int
+	text      string
+	startedAt time.Time
+	err       error
+}
+
+func (p *windowsProgress) run(opts options) {
+	defer close(p.done)
+
+	flags := uint32(tdfAllowDialogCancellation | tdfCallbackTimer)
+	if opts.pulsate {
+		flags |= tdfShowMarqueeProgressBar
+	} else {
+		flags |= tdfShowProgressBar
+	}
+
+	commonButtons := uint32(tdcbfCancelButton)
+	if opts.noCancel {
+		flags &^= tdfAllowDialogCancellation
+		commonButtons = 0
+	}
+
+	cfg := taskDialogConfig{
+		flags:           flags,
+		commonButtons:   commonButtons,
+		windowTitle:     utf16ptr(opts.title),
+		mainInstruction: utf16ptr(opts.text),
+		callback:        syscall.NewCallback(p.callback),
+	}
+	cfg.size = uint32(unsafe.Sizeof(cfg))
+
+	var button, radio, verified int32
+	hr, _, _ := taskDialogIndirect.Call(
+		uintptr(unsafe.Pointer(&cfg)),
+		uintptr(unsafe.Pointer(&button)),
+		uintptr(unsafe.Pointer(&radio)),
+		uintptr(unsafe.Pointer(&verified)))
+	if hr != 0 {
+		p.mu.Lock()
+		p.err = fmt.Errorf("TaskDialogIndirect: HRESULT 0x%08X", uint32(hr))
+		p.mu.Unlock()
+	}
+}
+
+func (p *windowsProgress) callback(hwnd uintptr, msg uint32, wparam, lparam, refdata uintptr) uintptr {
+	switch msg {
+	case tdnCreated:
+		select {
+		case p.hwnd <- hwnd:
+		default:
+		}
+	case tdnButtonClicked:
+		if p.autoKill && int32(wparam) == idCancel {
+			if proc, err := os.FindProcess(os.Getpid()); err == nil {
+				proc.Kill()
+			}
+		}
+	case tdnDestroyed:
+	}
+	return 0
+}
+
+func (p *windowsProgress) currentHwnd() (uintptr, bool) {
+	select {
+	case hwnd := <-p.hwnd:
+		p.hwnd <- hwnd
+		return hwnd, true
+	case <-p.done:
+		return 0, false
+	}
+}
+
+func (p *windowsProgress) Text(text string) error {
+	p.mu.Lock()
+	p.text = text
+	p.mu.Unlock()
+	if hwnd, ok := p.currentHwnd(); ok {
+		sendMessage(hwnd, tdmUpdateElementText, tdeMainInstruction, uintptr(unsafe.Pointer(utf16ptr(text))))
+	}
+	return nil
+}
+
+// updateRemaining appends a time-remaining estimate, extrapolated from the
+// elapsed time and current fraction complete, to the dialog's main
+// instruction text.
+func (p *windowsProgress) updateRemaining(hwnd uintptr, value, max int) {
+	if value <= 0 || max <= 0 {
+		return
+	}
+	p.mu.Lock()
+	if p.startedAt.IsZero() {
+		p.startedAt = time.Now()
+	}
+	text, started := p.text, p.startedAt
+	p.mu.Unlock()
+
+	remaining := time.Since(started) * time.Duration(max-value) / time.Duration(value)
+	if text != "" {
+		text += "\n"
+	}
+	text += fmt.Sprintf("Remaining - %s", remaining.Round(time.Second))
+	sendMessage(hwnd, tdmUpdateElementText, tdeMainInstruction, uintptr(unsafe.Pointer(utf16ptr(text))))
+}
+
+func (p *windowsProgress) Value(value int) error {
+	p.mu.Lock()
+	max := p.max
+	p.mu.Unlock()
+	if max <= 0 {
+		max = 100
+	}
+	if hwnd, ok := p.currentHwnd(); ok {
+		sendMessage(hwnd, tdmSetProgressBarMarquee, 0, 0)
+		sendMessage(hwnd, tdmSetMarqueeProgressBar, 0, 0)
+		sendMessage(hwnd, tdmSetProgressBarRange, 0, uintptr(max)<<16)
+		sendMessage(hwnd, tdmSetProgressBarPos, uintptr(value), 0)
+		if p.timeRemaining {
+			p.updateRemaining(hwnd, value, max)
+		}
+	}
+	if p.autoClose && value >= max {
+		return p.Close()
+	}
+	return nil
+}
+
+func (p *windowsProgress) MaxValue(value int) error {
+	p.mu.Lock()
+	p.max = value
+	p.mu.Unlock()
+	return nil
+}
+
+func (p *windowsProgress) Complete() error {
+	p.mu.Lock()
+	max := p.max
+	p.mu.Unlock()
+	return p.Value(max)
+}
+
+func (p *windowsProgress) Close() error {
+	if hwnd, ok := p.currentHwnd(); ok {
+		sendMessage(hwnd, wmClose, 0, 0)
+	}
+	return nil
+}
+
+func (p *windowsProgress) Done() <-chan struct{} {
+	return p.done
+}
+
+const wmClose = 0x0010
+
+func sendMessage(hwnd uintptr, msg uint32, wparam, lparam uintptr) uintptr {
+	user32 := syscall.NewLazyDLL("user32.dll")
+	proc := user32.NewProc("SendMessageW")
+	ret, _, _ := proc.Call(hwnd, uintptr(msg), wparam, lparam)
+	return ret
+}
+
+func utf16ptr(s string) *uint16 {
+	p, err := syscall.UTF16PtrFromString(s)
+	if err != nil {
+		return nil
+	}
+	return p
+}